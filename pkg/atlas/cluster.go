@@ -37,15 +37,80 @@ type Cluster struct {
 	ReplicationFactor        uint              `json:"replicationFactor,omitempty"`
 	ReplicationSpecs         []ReplicationSpec `json:"replicationSpecs,omitempty"`
 	ProviderSettings         *ProviderSettings `json:"providerSettings"`
+	Labels                   []Label           `json:"labels,omitempty"`
 
 	// Read-only attributes
-	State string `json:"stateName,omitempty"`
-	URI   string `json:"srvAddress,omitempty"`
+	StateName string `json:"stateName,omitempty"`
+	URI       string `json:"srvAddress,omitempty"`
+}
+
+// Label represents a key-value pair attached to a cluster. The broker uses
+// labels to stash its own bookkeeping (such as the OSB instance ID) on a
+// cluster without needing a dedicated Atlas API field.
+type Label struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// GetLabel returns the value of the label with the given key, or an empty
+// string if the cluster has no such label.
+func (c Cluster) GetLabel(key string) string {
+	for _, label := range c.Labels {
+		if label.Key == key {
+			return label.Value
+		}
+	}
+
+	return ""
+}
+
+// SetLabel sets the value of the label with the given key, overwriting any
+// existing label with that key or appending a new one.
+func (c *Cluster) SetLabel(key string, value string) {
+	for i, label := range c.Labels {
+		if label.Key == key {
+			c.Labels[i].Value = value
+			return
+		}
+	}
+
+	c.Labels = append(c.Labels, Label{Key: key, Value: value})
+}
+
+// GetName returns the cluster's name.
+func (c Cluster) GetName() string {
+	return c.Name
+}
+
+// GetStateName returns the cluster's current state.
+func (c Cluster) GetStateName() string {
+	return c.StateName
+}
+
+// GetURI returns the cluster's connection string.
+func (c Cluster) GetURI() string {
+	return c.URI
 }
 
 // AutoScalingConfig represents the autoscaling settings for a cluster.
+// DiskEnabled is a pointer so that an update can distinguish "not mentioned"
+// (nil, leave as-is) from "explicitly disabled" (pointing at false).
 type AutoScalingConfig struct {
-	DiskEnabled bool `json:"diskGBEnabled,omitempty"`
+	DiskEnabled *bool `json:"diskGBEnabled,omitempty"`
+
+	Compute ComputeAutoScalingConfig `json:"compute,omitempty"`
+}
+
+// ComputeAutoScalingConfig represents the compute autoscaling settings for a
+// cluster, bounding the instance sizes Atlas is allowed to scale between.
+// Enabled and ScaleDownEnabled are pointers for the same reason as
+// AutoScalingConfig.DiskEnabled: a nil means the caller didn't mention the
+// setting, as opposed to a pointer to false meaning they turned it off.
+type ComputeAutoScalingConfig struct {
+	Enabled          *bool  `json:"enabled,omitempty"`
+	ScaleDownEnabled *bool  `json:"scaleDownEnabled,omitempty"`
+	MinInstanceSize  string `json:"minInstanceSize,omitempty"`
+	MaxInstanceSize  string `json:"maxInstanceSize,omitempty"`
 }
 
 // BIConnectorConfig represents the BI connector settings for a cluster.
@@ -117,4 +182,4 @@ func (c *HTTPClient) GetCluster(name string) (*Cluster, error) {
 	var cluster Cluster
 	err := c.request(http.MethodGet, path, nil, &cluster)
 	return &cluster, err
-}
\ No newline at end of file
+}
@@ -0,0 +1,45 @@
+package atlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Event represents a single project event as returned by the Atlas events API.
+type Event struct {
+	ID        string `json:"id"`
+	EventType string `json:"eventTypeName"`
+	Message   string `json:"message,omitempty"`
+	Created   string `json:"created"`
+}
+
+// TerminalClusterFailureEvents are the event types that indicate a cluster
+// provisioning/update/deletion attempt has failed and won't resolve on its
+// own.
+var TerminalClusterFailureEvents = map[string]bool{
+	"CLUSTER_INSTANCE_FAILED": true,
+	"CLUSTER_CREATION_FAILED": true,
+	"CLUSTER_UPDATE_FAILED":   true,
+}
+
+// ListClusterEvents will fetch cluster events created on or after sinceTS (an
+// RFC3339 timestamp). An empty sinceTS fetches all events Atlas still has on
+// record for the cluster. Events of every type are returned; callers filter
+// by EventType themselves.
+// GET /events?clusterNames={CLUSTER-NAME}&minDate={TS}
+func (c *HTTPClient) ListClusterEvents(name string, sinceTS string) ([]Event, error) {
+	query := url.Values{}
+	query.Set("clusterNames", name)
+	if sinceTS != "" {
+		query.Set("minDate", sinceTS)
+	}
+
+	path := fmt.Sprintf("events?%s", query.Encode())
+
+	var result struct {
+		Results []Event `json:"results"`
+	}
+	err := c.request(http.MethodGet, path, nil, &result)
+	return result.Results, err
+}
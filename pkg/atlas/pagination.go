@@ -0,0 +1,56 @@
+package atlas
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListOptions controls pagination for list endpoints, matching the
+// PageNum/ItemsPerPage convention used throughout the Atlas API.
+type ListOptions struct {
+	PageNum      int
+	ItemsPerPage int
+}
+
+// ListClusters will fetch one page of clusters in the project. The returned
+// next page number is 0 once there are no more pages.
+// GET /clusters?pageNum={PAGE-NUM}&itemsPerPage={ITEMS-PER-PAGE}
+func (c *HTTPClient) ListClusters(opts ListOptions) (clusters []Cluster, next int, err error) {
+	query := url.Values{}
+	if opts.PageNum > 0 {
+		query.Set("pageNum", strconv.Itoa(opts.PageNum))
+	}
+	if opts.ItemsPerPage > 0 {
+		query.Set("itemsPerPage", strconv.Itoa(opts.ItemsPerPage))
+	}
+
+	path := fmt.Sprintf("clusters?%s", query.Encode())
+
+	var result struct {
+		Results    []Cluster `json:"results"`
+		TotalCount int       `json:"totalCount"`
+	}
+	if err = c.request(http.MethodGet, path, nil, &result); err != nil {
+		return nil, 0, err
+	}
+
+	clusters = result.Results
+
+	itemsPerPage := opts.ItemsPerPage
+	if itemsPerPage <= 0 {
+		itemsPerPage = len(clusters)
+	}
+
+	pageNum := opts.PageNum
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+
+	if itemsPerPage > 0 && pageNum*itemsPerPage < result.TotalCount {
+		next = pageNum + 1
+	}
+
+	return clusters, next, nil
+}
@@ -0,0 +1,41 @@
+package atlas
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ProcessArgs represents the advanced configuration options for a cluster's
+// mongod/mongos processes. JavascriptEnabled and NoTableScan are pointers so
+// that an explicit "false" can be told apart from the field being omitted
+// entirely - with a plain bool and omitempty, disabling one of these would
+// marshal to nothing and silently never reach Atlas.
+type ProcessArgs struct {
+	DefaultReadConcern        string `json:"defaultReadConcern,omitempty"`
+	JavascriptEnabled         *bool  `json:"javascriptEnabled,omitempty"`
+	MinimumEnabledTLSProtocol string `json:"minimumEnabledTlsProtocol,omitempty"`
+	NoTableScan               *bool  `json:"noTableScan,omitempty"`
+	OplogSizeMB               uint   `json:"oplogSizeMB,omitempty"`
+	SampleSizeBIConnector     uint   `json:"sampleSizeBIConnector,omitempty"`
+}
+
+// GetProcessArgs will fetch the advanced configuration options for a cluster.
+// GET /clusters/{CLUSTER-NAME}/processArgs
+func (c *HTTPClient) GetProcessArgs(name string) (*ProcessArgs, error) {
+	path := fmt.Sprintf("clusters/%s/processArgs", name)
+
+	var args ProcessArgs
+	err := c.request(http.MethodGet, path, nil, &args)
+	return &args, err
+}
+
+// UpdateProcessArgs will update the advanced configuration options for a
+// cluster.
+// PATCH /clusters/{CLUSTER-NAME}/processArgs
+func (c *HTTPClient) UpdateProcessArgs(name string, args ProcessArgs) (*ProcessArgs, error) {
+	path := fmt.Sprintf("clusters/%s/processArgs", name)
+
+	var resultingArgs ProcessArgs
+	err := c.request(http.MethodPatch, path, args, &resultingArgs)
+	return &resultingArgs, err
+}
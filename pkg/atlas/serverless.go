@@ -0,0 +1,113 @@
+package atlas
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ServerlessInstance represents a single serverless instance in Atlas.
+// Serverless instances are billed per-operation and, unlike dedicated
+// clusters, don't have a configurable instance size - only a backing
+// provider and region.
+type ServerlessInstance struct {
+	Name string `json:"name"`
+
+	ProviderSettings ServerlessProviderSettings `json:"providerSettings"`
+	Labels           []Label                    `json:"labels,omitempty"`
+
+	// Read-only attributes
+	StateName string `json:"stateName,omitempty"`
+	URI       string `json:"srvAddress,omitempty"`
+}
+
+// ServerlessProviderSettings represents the backing provider and region for a
+// serverless instance.
+type ServerlessProviderSettings struct {
+	BackingProviderName string `json:"backingProviderName"`
+	RegionName          string `json:"regionName"`
+}
+
+// GetLabel returns the value of the label with the given key, or an empty
+// string if the instance has no such label.
+func (s ServerlessInstance) GetLabel(key string) string {
+	for _, label := range s.Labels {
+		if label.Key == key {
+			return label.Value
+		}
+	}
+
+	return ""
+}
+
+// SetLabel sets the value of the label with the given key, overwriting any
+// existing label with that key or appending a new one.
+func (s *ServerlessInstance) SetLabel(key string, value string) {
+	for i, label := range s.Labels {
+		if label.Key == key {
+			s.Labels[i].Value = value
+			return
+		}
+	}
+
+	s.Labels = append(s.Labels, Label{Key: key, Value: value})
+}
+
+// GetName returns the instance's name.
+func (s ServerlessInstance) GetName() string {
+	return s.Name
+}
+
+// GetStateName returns the instance's current state.
+func (s ServerlessInstance) GetStateName() string {
+	return s.StateName
+}
+
+// GetURI returns the instance's connection string.
+func (s ServerlessInstance) GetURI() string {
+	return s.URI
+}
+
+// CreateServerlessInstance will create a new serverless instance asynchronously.
+// POST /serverless
+func (c *HTTPClient) CreateServerlessInstance(instance ServerlessInstance) (*ServerlessInstance, error) {
+	var resultingInstance ServerlessInstance
+	err := c.request(http.MethodPost, "serverless", instance, &resultingInstance)
+	return &resultingInstance, err
+}
+
+// UpdateServerlessInstance will update a serverless instance asynchronously.
+// PATCH /serverless/{INSTANCE-NAME}
+func (c *HTTPClient) UpdateServerlessInstance(instance ServerlessInstance) (*ServerlessInstance, error) {
+	path := fmt.Sprintf("serverless/%s", instance.Name)
+
+	var resultingInstance ServerlessInstance
+	err := c.request(http.MethodPatch, path, instance, &resultingInstance)
+	return &resultingInstance, err
+}
+
+// DeleteServerlessInstance will terminate a serverless instance asynchronously.
+// DELETE /serverless/{INSTANCE-NAME}
+func (c *HTTPClient) DeleteServerlessInstance(name string) error {
+	path := fmt.Sprintf("serverless/%s", name)
+	return c.request(http.MethodDelete, path, nil, nil)
+}
+
+// GetServerlessInstance will find a serverless instance by name.
+// GET /serverless/{INSTANCE-NAME}
+func (c *HTTPClient) GetServerlessInstance(name string) (*ServerlessInstance, error) {
+	path := fmt.Sprintf("serverless/%s", name)
+
+	var instance ServerlessInstance
+	err := c.request(http.MethodGet, path, nil, &instance)
+	return &instance, err
+}
+
+// ListServerlessInstances will fetch all serverless instances in the project.
+// GET /serverless
+func (c *HTTPClient) ListServerlessInstances() ([]ServerlessInstance, error) {
+	var result struct {
+		Results []ServerlessInstance `json:"results"`
+	}
+	err := c.request(http.MethodGet, "serverless", nil, &result)
+	return result.Results, err
+}
@@ -0,0 +1,68 @@
+package broker
+
+import (
+	"context"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// ClusterIndexRefreshInterval is how often DefaultClusterIndex is refreshed
+// from Atlas once the broker starts.
+const ClusterIndexRefreshInterval = 30 * time.Second
+
+// clusterIndexSize and clusterIndexRefreshLag expose DefaultClusterIndex's
+// state so operators can tell whether findInstanceByInstanceID is actually
+// getting fast, indexed hits or silently falling back to a full Atlas scan.
+var (
+	clusterIndexSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "aosb_cluster_index_size",
+		Help: "Number of clusters currently held in the cluster index.",
+	})
+	clusterIndexRefreshLag = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "aosb_cluster_index_refresh_lag_seconds",
+		Help: "Seconds since the cluster index was last refreshed from Atlas.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(clusterIndexSize, clusterIndexRefreshLag)
+}
+
+// Broker implements the Open Service Broker API against the Atlas API.
+type Broker struct {
+	logger *zap.SugaredLogger
+}
+
+// New creates a Broker and starts DefaultClusterIndex refreshing in the
+// background, so repeated OSB calls don't each fall back to listing every
+// cluster in the project. The returned Broker stops refreshing once ctx is
+// cancelled.
+func New(ctx context.Context, client atlas.Client, logger *zap.SugaredLogger) Broker {
+	DefaultClusterIndex.StartRefreshing(ctx, client, ClusterIndexRefreshInterval, logger)
+	go reportClusterIndexMetrics(ctx, DefaultClusterIndex)
+
+	return Broker{logger: logger}
+}
+
+// reportClusterIndexMetrics keeps the cluster index gauges up to date until
+// ctx is cancelled.
+func reportClusterIndexMetrics(ctx context.Context, idx *ClusterIndex) {
+	const interval = time.Second
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		clusterIndexSize.Set(float64(idx.Size()))
+		clusterIndexRefreshLag.Set(idx.RefreshLag().Seconds())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package broker
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
+)
+
+// noopLogger returns a logger that discards everything, for tests that need
+// a Broker but don't care about its log output.
+func noopLogger() *zap.SugaredLogger {
+	return zap.NewNop().Sugar()
+}
+
+// fakeAtlasClient is a minimal in-memory stand-in for atlas.Client, letting
+// tests drive the broker's state machine without talking to a real Atlas
+// project. Only the fields a given test populates are meaningful; everything
+// else behaves as if Atlas has nothing on record.
+type fakeAtlasClient struct {
+	clusters            []atlas.Cluster
+	serverlessInstances []atlas.ServerlessInstance
+	processArgs         atlas.ProcessArgs
+	events              []atlas.Event
+	updatedClusters     []atlas.Cluster
+	updatedProcessArgs  []atlas.ProcessArgs
+
+	updatedServerlessInstances []atlas.ServerlessInstance
+}
+
+func (f *fakeAtlasClient) CreateCluster(cluster atlas.Cluster) (*atlas.Cluster, error) {
+	f.clusters = append(f.clusters, cluster)
+	return &cluster, nil
+}
+
+func (f *fakeAtlasClient) UpdateCluster(cluster atlas.Cluster) (*atlas.Cluster, error) {
+	f.updatedClusters = append(f.updatedClusters, cluster)
+	return &cluster, nil
+}
+
+func (f *fakeAtlasClient) DeleteCluster(name string) error {
+	return nil
+}
+
+func (f *fakeAtlasClient) GetCluster(name string) (*atlas.Cluster, error) {
+	for _, cluster := range f.clusters {
+		if cluster.Name == name {
+			return &cluster, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (f *fakeAtlasClient) GetClusters() ([]atlas.Cluster, error) {
+	return f.clusters, nil
+}
+
+func (f *fakeAtlasClient) ListClusters(opts atlas.ListOptions) ([]atlas.Cluster, int, error) {
+	return f.clusters, 0, nil
+}
+
+func (f *fakeAtlasClient) ListClusterEvents(name string, sinceTS string) ([]atlas.Event, error) {
+	return f.events, nil
+}
+
+func (f *fakeAtlasClient) GetProcessArgs(name string) (*atlas.ProcessArgs, error) {
+	args := f.processArgs
+	return &args, nil
+}
+
+func (f *fakeAtlasClient) UpdateProcessArgs(name string, args atlas.ProcessArgs) (*atlas.ProcessArgs, error) {
+	f.updatedProcessArgs = append(f.updatedProcessArgs, args)
+	return &args, nil
+}
+
+func (f *fakeAtlasClient) CreateServerlessInstance(instance atlas.ServerlessInstance) (*atlas.ServerlessInstance, error) {
+	f.serverlessInstances = append(f.serverlessInstances, instance)
+	return &instance, nil
+}
+
+func (f *fakeAtlasClient) UpdateServerlessInstance(instance atlas.ServerlessInstance) (*atlas.ServerlessInstance, error) {
+	f.updatedServerlessInstances = append(f.updatedServerlessInstances, instance)
+	return &instance, nil
+}
+
+func (f *fakeAtlasClient) DeleteServerlessInstance(name string) error {
+	return nil
+}
+
+func (f *fakeAtlasClient) GetServerlessInstance(name string) (*atlas.ServerlessInstance, error) {
+	for _, instance := range f.serverlessInstances {
+		if instance.Name == name {
+			return &instance, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (f *fakeAtlasClient) ListServerlessInstances() ([]atlas.ServerlessInstance, error) {
+	return f.serverlessInstances, nil
+}
+
+func (f *fakeAtlasClient) GetDashboardURL(name string) string {
+	return ""
+}
+
+type notFoundError struct{}
+
+func (notFoundError) Error() string { return "not found" }
+
+var errNotFound = notFoundError{}
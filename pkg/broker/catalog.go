@@ -0,0 +1,28 @@
+package broker
+
+import "github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
+
+// Plan describes the broker-relevant metadata for a single service-catalog
+// plan - the subset of the full OSB plan definition that
+// PlanAutoScalingDefaults and ServerlessPlanIDs need to be populated from.
+type Plan struct {
+	ID                string
+	Serverless        bool
+	AutoScalingConfig atlas.AutoScalingConfig
+}
+
+// ConfigurePlans populates PlanAutoScalingDefaults and ServerlessPlanIDs from
+// the service catalog's plan metadata. It should be called once at broker
+// start-up, before the broker starts handling requests, with every plan the
+// catalog declares.
+func ConfigurePlans(plans []Plan) {
+	for _, plan := range plans {
+		if plan.Serverless {
+			ServerlessPlanIDs[plan.ID] = true
+		}
+
+		if plan.AutoScalingConfig != (atlas.AutoScalingConfig{}) {
+			PlanAutoScalingDefaults[plan.ID] = plan.AutoScalingConfig
+		}
+	}
+}
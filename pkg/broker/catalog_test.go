@@ -0,0 +1,58 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
+)
+
+// TestConfigurePlans_PopulatesAutoScalingDefaults verifies that a plan
+// declaring autoscaling bounds in the catalog ends up in
+// PlanAutoScalingDefaults, and that a plan with none declared is left alone.
+func TestConfigurePlans_PopulatesAutoScalingDefaults(t *testing.T) {
+	originalDefaults := PlanAutoScalingDefaults
+	PlanAutoScalingDefaults = map[string]atlas.AutoScalingConfig{}
+	defer func() { PlanAutoScalingDefaults = originalDefaults }()
+
+	bounds := atlas.AutoScalingConfig{
+		Compute: atlas.ComputeAutoScalingConfig{
+			MinInstanceSize: "M10",
+			MaxInstanceSize: "M40",
+		},
+	}
+
+	ConfigurePlans([]Plan{
+		{ID: "plan-with-autoscaling", AutoScalingConfig: bounds},
+		{ID: "plan-without-autoscaling"},
+	})
+
+	if got, ok := PlanAutoScalingDefaults["plan-with-autoscaling"]; !ok || got != bounds {
+		t.Errorf("PlanAutoScalingDefaults[%q] = %+v, %v, want %+v, true", "plan-with-autoscaling", got, ok, bounds)
+	}
+
+	if _, ok := PlanAutoScalingDefaults["plan-without-autoscaling"]; ok {
+		t.Error("expected a plan with no declared autoscaling bounds to be left out of PlanAutoScalingDefaults")
+	}
+}
+
+// TestConfigurePlans_PopulatesServerlessPlanIDs verifies that a plan marked
+// "serverless: true" in the catalog ends up in ServerlessPlanIDs, and that a
+// dedicated-cluster plan is left alone.
+func TestConfigurePlans_PopulatesServerlessPlanIDs(t *testing.T) {
+	originalServerlessPlanIDs := ServerlessPlanIDs
+	ServerlessPlanIDs = map[string]bool{}
+	defer func() { ServerlessPlanIDs = originalServerlessPlanIDs }()
+
+	ConfigurePlans([]Plan{
+		{ID: "serverless-plan", Serverless: true},
+		{ID: "dedicated-plan"},
+	})
+
+	if !ServerlessPlanIDs["serverless-plan"] {
+		t.Error("expected the plan marked Serverless to be added to ServerlessPlanIDs")
+	}
+
+	if ServerlessPlanIDs["dedicated-plan"] {
+		t.Error("expected a plan not marked Serverless to be left out of ServerlessPlanIDs")
+	}
+}
@@ -0,0 +1,131 @@
+package broker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
+	"go.uber.org/zap"
+)
+
+// ClusterIndexPageSize is how many clusters are requested per page while
+// refreshing a ClusterIndex.
+const ClusterIndexPageSize = 500
+
+// DefaultClusterIndex is consulted by findInstanceByInstanceID before it
+// falls back to a full Atlas scan. It's empty - and therefore always a miss -
+// until something starts refreshing it, which broker.New ordinarily does
+// once at start-up so OSB calls and reconciliation don't linearly re-list
+// every cluster in the project on every request.
+var DefaultClusterIndex = NewClusterIndex()
+
+// ClusterIndex is a bounded-TTL, in-memory index of clusters keyed by their
+// aosb-instance-id label.
+type ClusterIndex struct {
+	mu          sync.RWMutex
+	byInstance  map[string]atlas.Cluster
+	lastRefresh time.Time
+	ttl         time.Duration
+}
+
+// NewClusterIndex creates an empty index with a 30 second TTL. Call Refresh
+// (or StartRefreshing) before relying on it to have been populated.
+func NewClusterIndex() *ClusterIndex {
+	return &ClusterIndex{
+		byInstance: make(map[string]atlas.Cluster),
+		ttl:        30 * time.Second,
+	}
+}
+
+// Get returns the cluster for an instance ID, and whether the index held a
+// fresh enough entry for it.
+func (idx *ClusterIndex) Get(instanceID string) (atlas.Cluster, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if idx.lastRefresh.IsZero() || time.Since(idx.lastRefresh) > idx.ttl {
+		return atlas.Cluster{}, false
+	}
+
+	cluster, ok := idx.byInstance[instanceID]
+	return cluster, ok
+}
+
+// Size returns the number of clusters currently indexed, for the
+// aosb_cluster_index_size metric.
+func (idx *ClusterIndex) Size() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.byInstance)
+}
+
+// RefreshLag returns how long it's been since the index was last refreshed,
+// for the aosb_cluster_index_refresh_lag_seconds metric.
+func (idx *ClusterIndex) RefreshLag() time.Duration {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if idx.lastRefresh.IsZero() {
+		return 0
+	}
+	return time.Since(idx.lastRefresh)
+}
+
+// Refresh re-populates the index from Atlas, paging through ListClusters.
+func (idx *ClusterIndex) Refresh(client atlas.Client) error {
+	byInstance := make(map[string]atlas.Cluster)
+
+	opts := atlas.ListOptions{ItemsPerPage: ClusterIndexPageSize}
+	for {
+		clusters, next, err := client.ListClusters(opts)
+		if err != nil {
+			return err
+		}
+
+		for _, cluster := range clusters {
+			if instanceID := cluster.GetLabel(InstanceIDLabel); instanceID != "" {
+				byInstance[instanceID] = cluster
+			}
+		}
+
+		if next == 0 {
+			break
+		}
+		opts.PageNum = next
+	}
+
+	idx.mu.Lock()
+	idx.byInstance = byInstance
+	idx.lastRefresh = time.Now()
+	idx.mu.Unlock()
+
+	return nil
+}
+
+// StartRefreshing refreshes the index immediately and then every interval
+// until ctx is cancelled. The refreshing runs in a goroutine of its own, so
+// StartRefreshing returns at once; broker.New calls it at start-up.
+func (idx *ClusterIndex) StartRefreshing(ctx context.Context, client atlas.Client, interval time.Duration, logger *zap.SugaredLogger) {
+	refresh := func() {
+		if err := idx.Refresh(client); err != nil {
+			logger.Errorw("Failed to refresh cluster index", "error", err)
+		}
+	}
+
+	go func() {
+		refresh()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				refresh()
+			}
+		}
+	}()
+}
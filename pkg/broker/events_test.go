@@ -0,0 +1,115 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
+)
+
+// TestCheckForTerminalFailure covers a cluster stuck in a non-terminal state
+// (e.g. REPAIRING) whose events include a terminal failure: it must be
+// reported, and the event cursor must advance so the next poll doesn't
+// re-scan the same events.
+func TestCheckForTerminalFailure(t *testing.T) {
+	cluster := atlas.Cluster{Name: "cluster0"}
+
+	client := &fakeAtlasClient{
+		clusters: []atlas.Cluster{cluster},
+		events: []atlas.Event{
+			{ID: "1", EventType: "CLUSTER_CREATING", Created: "2026-01-01T00:00:00Z"},
+			{ID: "2", EventType: "CLUSTER_CREATION_FAILED", Message: "ran out of quota", Created: "2026-01-01T00:01:00Z"},
+		},
+	}
+
+	b := Broker{logger: noopLogger()}
+	message := b.checkForTerminalFailure(client, cluster)
+
+	if message != "ran out of quota" {
+		t.Errorf("checkForTerminalFailure() = %q, want the terminal failure message", message)
+	}
+
+	if len(client.updatedClusters) != 1 {
+		t.Fatalf("expected the event cursor to be advanced via UpdateCluster, got %d updates", len(client.updatedClusters))
+	}
+
+	if got := client.updatedClusters[0].GetLabel(EventCursorLabel); got != "2026-01-01T00:01:00Z" {
+		t.Errorf("event cursor label = %q, want the timestamp of the last seen event", got)
+	}
+}
+
+// TestCheckForTerminalFailure_NoFailureEvents guards against false
+// positives: a cluster that's merely slow to reconcile, with no terminal
+// event, should be left to keep polling.
+func TestCheckForTerminalFailure_NoFailureEvents(t *testing.T) {
+	cluster := atlas.Cluster{Name: "cluster0"}
+
+	client := &fakeAtlasClient{
+		events: []atlas.Event{
+			{ID: "1", EventType: "CLUSTER_CREATING", Created: "2026-01-01T00:00:00Z"},
+		},
+	}
+
+	b := Broker{logger: noopLogger()}
+	if message := b.checkForTerminalFailure(client, cluster); message != "" {
+		t.Errorf("checkForTerminalFailure() = %q, want no failure reported", message)
+	}
+}
+
+// TestCheckForTerminalFailure_CursorOnlyAdvancesOnNewEvents makes sure a poll
+// that sees nothing new doesn't needlessly rewrite the cluster's label.
+func TestCheckForTerminalFailure_CursorOnlyAdvancesOnNewEvents(t *testing.T) {
+	cluster := atlas.Cluster{
+		Name:   "cluster0",
+		Labels: []atlas.Label{{Key: EventCursorLabel, Value: "2026-01-01T00:01:00Z"}},
+	}
+
+	client := &fakeAtlasClient{}
+
+	b := Broker{logger: noopLogger()}
+	if message := b.checkForTerminalFailure(client, cluster); message != "" {
+		t.Errorf("checkForTerminalFailure() = %q, want no failure reported", message)
+	}
+
+	if len(client.updatedClusters) != 0 {
+		t.Errorf("expected no UpdateCluster call when there are no new events, got %d", len(client.updatedClusters))
+	}
+}
+
+// TestCheckForTerminalFailure_AdvancesCursorAgainstFreshCluster guards
+// against the regression this fix addressed: the cluster handed to
+// checkForTerminalFailure can be a stale copy served from
+// DefaultClusterIndex, so advancing the event cursor must PATCH a freshly
+// fetched cluster rather than resubmitting the stale one and clobbering
+// whatever changed elsewhere in the meantime.
+func TestCheckForTerminalFailure_AdvancesCursorAgainstFreshCluster(t *testing.T) {
+	staleCluster := atlas.Cluster{
+		Name:             "cluster0",
+		ProviderSettings: &atlas.ProviderSettings{Instance: "M10"},
+	}
+	freshCluster := atlas.Cluster{
+		Name:             "cluster0",
+		ProviderSettings: &atlas.ProviderSettings{Instance: "M20"},
+	}
+
+	client := &fakeAtlasClient{
+		clusters: []atlas.Cluster{freshCluster},
+		events: []atlas.Event{
+			{ID: "1", EventType: "CLUSTER_CREATING", Created: "2026-01-01T00:00:00Z"},
+		},
+	}
+
+	b := Broker{logger: noopLogger()}
+	b.checkForTerminalFailure(client, staleCluster)
+
+	if len(client.updatedClusters) != 1 {
+		t.Fatalf("expected exactly one UpdateCluster call, got %d", len(client.updatedClusters))
+	}
+
+	if got := client.updatedClusters[0].ProviderSettings.Instance; got != "M20" {
+		t.Errorf("expected the cursor update to PATCH the freshly fetched cluster, got a cluster with Instance %q (the stale value)", got)
+	}
+
+	if got := client.updatedClusters[0].GetLabel(EventCursorLabel); got != "2026-01-01T00:00:00Z" {
+		t.Errorf("event cursor label = %q, want the timestamp of the last seen event", got)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
 
 	"github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
 	"github.com/pivotal-cf/brokerapi"
@@ -22,6 +24,52 @@ const (
 // InstanceIDLabel is the label key under which the instance ID will be saved.
 const InstanceIDLabel = "aosb-instance-id"
 
+// ProcessArgsLabel is the label key under which the caller-requested process
+// args are saved (JSON-encoded), so LastOperation can tell once Atlas has
+// reconciled them.
+const ProcessArgsLabel = "aosb-process-args"
+
+// DeletionProtectionLabel is the label key under which a cluster's deletion
+// protection setting is saved.
+const DeletionProtectionLabel = "aosb-deletion-protection"
+
+// EventCursorLabel is the label key under which the timestamp of the last
+// cluster event a poll has seen is saved, so that successive LastOperation
+// polls only need to ask Atlas for events since then.
+const EventCursorLabel = "aosb-event-cursor"
+
+// managedInstance is satisfied by both atlas.Cluster and
+// atlas.ServerlessInstance, letting the broker's provisioning state machine
+// (findInstanceByInstanceID, LastOperation) treat dedicated clusters and
+// serverless instances uniformly.
+type managedInstance interface {
+	GetName() string
+	GetStateName() string
+	GetURI() string
+	GetLabel(key string) string
+}
+
+// PlanAutoScalingDefaults lets operators declare, per plan ID, the
+// autoscaling bounds that should be used when a provision/update call omits
+// "autoScaling" entirely. It's consulted by clusterFromParams and is empty
+// unless populated at broker start-up via ConfigurePlans.
+var PlanAutoScalingDefaults = map[string]atlas.AutoScalingConfig{}
+
+// DefaultDeletionProtection controls whether clusters are deletion-protected
+// by default when the "deletionProtection" parameter is omitted from a
+// provision/update call. Operators can flip this broker-wide to make
+// protection opt-out rather than opt-in.
+var DefaultDeletionProtection = false
+
+// ErrClusterDeletionProtected is returned when a deprovision call targets a
+// cluster that has deletion protection enabled. The platform (and operator)
+// must first disable protection via an update before the delete can proceed.
+var ErrClusterDeletionProtected = apiresponses.NewFailureResponseBuilder(
+	fmt.Errorf("cluster has deletion protection enabled; disable it with an update before deleting"),
+	422,
+	"deletion-protection-enabled",
+).Build()
+
 // Provision will create a new Atlas cluster with the instance ID as its name.
 // The process is always async.
 func (b Broker) Provision(ctx context.Context, instanceID string, details brokerapi.ProvisionDetails, asyncAllowed bool) (spec brokerapi.ProvisionedServiceSpec, err error) {
@@ -38,8 +86,12 @@ func (b Broker) Provision(ctx context.Context, instanceID string, details broker
 		return
 	}
 
+	if ServerlessPlanIDs[details.PlanID] {
+		return b.provisionServerless(client, instanceID, details)
+	}
+
 	// Construct a cluster definition from the instance ID, service, plan, and params.
-	cluster, err := clusterFromParams(client, instanceID, details.ServiceID, details.PlanID, details.RawParameters)
+	cluster, processArgs, deletionProtection, err := clusterFromParams(client, instanceID, details.ServiceID, details.PlanID, details.RawParameters)
 	if err != nil {
 		b.logger.Errorw("Couldn't create cluster from the passed parameters", "error", err, "instance_id", instanceID, "details", details)
 		return
@@ -54,6 +106,7 @@ func (b Broker) Provision(ctx context.Context, instanceID string, details broker
 	cluster.Name = clusterName
 
 	cluster.SetLabel(InstanceIDLabel, instanceID)
+	cluster.SetLabel(DeletionProtectionLabel, strconv.FormatBool(resolveDeletionProtection(deletionProtection, DefaultDeletionProtection)))
 
 	// Create a new Atlas cluster from the generated definition
 	resultingCluster, err := client.CreateCluster(*cluster)
@@ -65,6 +118,15 @@ func (b Broker) Provision(ctx context.Context, instanceID string, details broker
 
 	b.logger.Infow("Successfully started Atlas creation process", "instance_id", instanceID, "cluster", resultingCluster)
 
+	// Process args can't be set until the cluster exists, but we don't need to
+	// wait for it to finish creating. LastOperation will keep polling until
+	// Atlas has reconciled them.
+	if processArgs != nil {
+		if _, err := client.UpdateProcessArgs(resultingCluster.Name, *processArgs); err != nil {
+			b.logger.Errorw("Failed to apply process args to Atlas cluster", "error", err, "instance_id", instanceID)
+		}
+	}
+
 	return brokerapi.ProvisionedServiceSpec{
 		IsAsync:       true,
 		OperationData: OperationProvision,
@@ -87,23 +149,37 @@ func (b Broker) Update(ctx context.Context, instanceID string, details brokerapi
 		return
 	}
 
-	// Fetch the cluster from Atlas. The Atlas API requires an instance size to
+	// Fetch the instance from Atlas. The Atlas API requires an instance size to
 	// be passed during updates (if there are other update to the provider, such
 	// as region). The plan is not included in the OSB call unless it has changed
 	// hence we need to fetch the current value from Atlas.
-	existingCluster, err := findClusterByInstanceID(client, instanceID)
+	existingInstance, err := findInstanceByInstanceID(client, instanceID)
 	if err != nil {
 		return
 	}
 
+	// Serverless instances are updated through a separate, simpler flow: there's
+	// no instance size or autoscaling state to merge.
+	if existingServerless, ok := existingInstance.(atlas.ServerlessInstance); ok {
+		return b.updateServerless(client, instanceID, details, existingServerless)
+	}
+	existingCluster := existingInstance.(atlas.Cluster)
+
 	// Construct a cluster from the instance ID, service, plan, and params.
-	cluster, err := clusterFromParams(client, instanceID, details.ServiceID, details.PlanID, details.RawParameters)
+	cluster, processArgs, deletionProtection, err := clusterFromParams(client, instanceID, details.ServiceID, details.PlanID, details.RawParameters)
 	if err != nil {
 		return
 	}
 
 	cluster.Name = existingCluster.Name
 
+	// Preserve deletion protection, rather than overwrite it, the same way
+	// autoscaling is merged below - an update that doesn't mention
+	// "deletionProtection" shouldn't silently revert it to the broker-wide
+	// default.
+	existingDeletionProtection := existingCluster.GetLabel(DeletionProtectionLabel) == "true"
+	cluster.SetLabel(DeletionProtectionLabel, strconv.FormatBool(resolveDeletionProtection(deletionProtection, existingDeletionProtection)))
+
 	// Make sure the cluster provider has all the neccessary params for the
 	// Atlas API. The Atlas API requires both the provider name and instance
 	// size if the provider object is set. If they are missing we use the
@@ -118,6 +194,12 @@ func (b Broker) Update(ctx context.Context, instanceID string, details brokerapi
 		}
 	}
 
+	// Merge, rather than overwrite, the autoscaling state. Atlas's own
+	// autoscaler may have moved the instance size since the last OSB update,
+	// and an update that doesn't mention autoScaling at all shouldn't clobber
+	// that.
+	mergeAutoScaling(&cluster.AutoScaling, existingCluster.AutoScaling)
+
 	resultingCluster, err := client.UpdateCluster(*cluster)
 	if err != nil {
 		b.logger.Errorw("Failed to update Atlas cluster", "error", err, "cluster", cluster)
@@ -127,6 +209,12 @@ func (b Broker) Update(ctx context.Context, instanceID string, details brokerapi
 
 	b.logger.Infow("Successfully started Atlas cluster update process", "instance_id", instanceID, "cluster", resultingCluster)
 
+	if processArgs != nil {
+		if _, err := client.UpdateProcessArgs(resultingCluster.Name, *processArgs); err != nil {
+			b.logger.Errorw("Failed to apply process args to Atlas cluster", "error", err, "instance_id", instanceID)
+		}
+	}
+
 	return brokerapi.UpdateServiceSpec{
 		IsAsync:       true,
 		OperationData: OperationUpdate,
@@ -149,19 +237,30 @@ func (b Broker) Deprovision(ctx context.Context, instanceID string, details brok
 		return
 	}
 
-	cluster, err := findClusterByInstanceID(client, instanceID)
+	instance, err := findInstanceByInstanceID(client, instanceID)
 	if err != nil {
 		return
 	}
 
-	err = client.DeleteCluster(cluster.Name)
+	if isDeletionProtected(instance) {
+		b.logger.Infow("Refusing to delete instance with deletion protection enabled", "instance_id", instanceID)
+		err = ErrClusterDeletionProtected
+		return
+	}
+
+	switch v := instance.(type) {
+	case atlas.Cluster:
+		err = client.DeleteCluster(v.Name)
+	case atlas.ServerlessInstance:
+		err = client.DeleteServerlessInstance(v.Name)
+	}
 	if err != nil {
-		b.logger.Errorw("Failed to delete Atlas cluster", "error", err, "instance_id", instanceID)
+		b.logger.Errorw("Failed to delete Atlas instance", "error", err, "instance_id", instanceID)
 		err = atlasToAPIError(err)
 		return
 	}
 
-	b.logger.Infow("Successfully started Atlas cluster deletion process", "instance_id", instanceID)
+	b.logger.Infow("Successfully started Atlas instance deletion process", "instance_id", instanceID)
 
 	return brokerapi.DeprovisionServiceSpec{
 		IsAsync:       true,
@@ -178,7 +277,7 @@ func (b Broker) GetInstance(ctx context.Context, instanceID string) (spec broker
 }
 
 // LastOperation should fetch the state of the provision/deprovision
-// of a cluster.
+// of a cluster or serverless instance.
 func (b Broker) LastOperation(ctx context.Context, instanceID string, details brokerapi.PollDetails) (resp brokerapi.LastOperation, err error) {
 	b.logger.Infow("Fetching state of last operation", "instance_id", instanceID, "details", details)
 
@@ -187,47 +286,76 @@ func (b Broker) LastOperation(ctx context.Context, instanceID string, details br
 		return
 	}
 
-	cluster, err := findClusterByInstanceID(client, instanceID)
+	instance, err := findInstanceByInstanceID(client, instanceID)
 	if err != nil && err != brokerapi.ErrInstanceDoesNotExist {
-		b.logger.Errorw("Failed to get existing cluster", "error", err, "instance_id", instanceID)
+		b.logger.Errorw("Failed to get existing instance", "error", err, "instance_id", instanceID)
 		return
 	}
+	notFound := err == brokerapi.ErrInstanceDoesNotExist
 
-	b.logger.Infow("Found existing cluster", "cluster", cluster)
+	b.logger.Infow("Found existing instance", "instance", instance)
+
+	stateName := ""
+	if !notFound {
+		stateName = instance.GetStateName()
+	}
 
 	state := brokerapi.LastOperationState(brokerapi.Failed)
+	description := ""
 
 	switch details.OperationData {
 	case OperationProvision:
-		switch cluster.StateName {
-		// Provision has succeeded if the cluster is in state "idle".
+		switch stateName {
+		// Provision has succeeded once the cluster is "idle" and, for
+		// dedicated clusters, any requested process args have been
+		// reconciled. Serverless instances have no process args to gate on.
 		case atlas.ClusterStateIdle:
-			state = brokerapi.Succeeded
-		case atlas.ClusterStateCreating:
+			state = brokerapi.InProgress
+			if cluster, ok := instance.(atlas.Cluster); !ok || b.processArgsReconciled(client, cluster) {
+				state = brokerapi.Succeeded
+			}
+		case atlas.ClusterStateCreating, atlas.ClusterStateRepairing:
 			state = brokerapi.InProgress
 		}
 	case OperationDeprovision:
 		// The Atlas API may return a 404 response if a cluster is deleted or it
 		// will return the cluster with a state of "DELETED". Both of these
 		// scenarios indicate that a cluster has been successfully deleted.
-		if err == brokerapi.ErrInstanceDoesNotExist || cluster.StateName == atlas.ClusterStateDeleted {
+		if notFound || stateName == atlas.ClusterStateDeleted {
 			state = brokerapi.Succeeded
-		} else if cluster.StateName == atlas.ClusterStateDeleting {
+		} else if stateName == atlas.ClusterStateDeleting || stateName == atlas.ClusterStateRepairing {
 			state = brokerapi.InProgress
 		}
 	case OperationUpdate:
 		// We assume that the cluster transitions to the "UPDATING" state
 		// in a synchronous manner during the update request.
-		switch cluster.StateName {
+		switch stateName {
 		case atlas.ClusterStateIdle:
-			state = brokerapi.Succeeded
-		case atlas.ClusterStateUpdating:
+			state = brokerapi.InProgress
+			if cluster, ok := instance.(atlas.Cluster); !ok || b.processArgsReconciled(client, cluster) {
+				state = brokerapi.Succeeded
+			}
+		case atlas.ClusterStateUpdating, atlas.ClusterStateRepairing:
 			state = brokerapi.InProgress
 		}
 	}
 
+	// A cluster can sit in "REPAIRING" (or another non-terminal state)
+	// indefinitely when the underlying provisioning attempt has actually
+	// failed. Check events since the last poll for a terminal failure rather
+	// than waiting on the state machine alone.
+	if state == brokerapi.InProgress {
+		if cluster, ok := instance.(atlas.Cluster); ok {
+			if message := b.checkForTerminalFailure(client, cluster); message != "" {
+				state = brokerapi.Failed
+				description = message
+			}
+		}
+	}
+
 	return brokerapi.LastOperation{
-		State: state,
+		State:       state,
+		Description: description,
 	}, nil
 }
 
@@ -245,36 +373,88 @@ func NormalizeClusterName(name string) string {
 	return name
 }
 
+// mergeAutoScaling fills in any autoscaling fields left unset on cluster with
+// the corresponding value from existing, so that an update which doesn't
+// mention autoscaling (or only partially configures it) doesn't undo bounds
+// that Atlas's own autoscaler or a previous OSB call already put in place.
+// The boolean fields are pointers specifically so "unset" (nil) and
+// "explicitly false" can be told apart here - an update that asks to turn
+// autoscaling off must not be overwritten by the existing "on" value.
+func mergeAutoScaling(cluster *atlas.AutoScalingConfig, existing atlas.AutoScalingConfig) {
+	if cluster.DiskEnabled == nil {
+		cluster.DiskEnabled = existing.DiskEnabled
+	}
+
+	if cluster.Compute.Enabled == nil {
+		cluster.Compute.Enabled = existing.Compute.Enabled
+	}
+
+	if cluster.Compute.ScaleDownEnabled == nil {
+		cluster.Compute.ScaleDownEnabled = existing.Compute.ScaleDownEnabled
+	}
+
+	if cluster.Compute.MinInstanceSize == "" {
+		cluster.Compute.MinInstanceSize = existing.Compute.MinInstanceSize
+	}
+
+	if cluster.Compute.MaxInstanceSize == "" {
+		cluster.Compute.MaxInstanceSize = existing.Compute.MaxInstanceSize
+	}
+}
+
 // clusterFromParams will construct a cluster object from an instance ID,
 // service, plan, and raw parameters. This way users can pass all the
-// configuration available for clusters in the Atlas API as "cluster" in the params.
-func clusterFromParams(client atlas.Client, instanceID string, serviceID string, planID string, rawParams []byte) (*atlas.Cluster, error) {
+// configuration available for clusters in the Atlas API as "cluster" in the
+// params. It also returns the process args requested via a sibling
+// "processArgs" block, if any, and the requested deletion protection setting,
+// if the caller specified one - the caller decides what to fall back to when
+// it's nil, since that differs between a fresh provision and an update.
+func clusterFromParams(client atlas.Client, instanceID string, serviceID string, planID string, rawParams []byte) (*atlas.Cluster, *atlas.ProcessArgs, *bool, error) {
 	// Set up a params object which will be used for deserialiation.
+	// ProcessArgs is captured as raw JSON, rather than decoded straight into
+	// an atlas.ProcessArgs, so that the exact set of fields the caller asked
+	// for survives into the ProcessArgsLabel below - decoding and re-encoding
+	// would lose that, since every field on ProcessArgs also omits its zero
+	// value.
 	params := struct {
-		Cluster *atlas.Cluster `json:"cluster"`
+		Cluster            *atlas.Cluster  `json:"cluster"`
+		DeletionProtection *bool           `json:"deletionProtection"`
+		ProcessArgs        json.RawMessage `json:"processArgs"`
 	}{
-		&atlas.Cluster{},
+		Cluster: &atlas.Cluster{},
 	}
 
 	// If params were passed we unmarshal them into the params object.
 	if len(rawParams) > 0 {
 		err := json.Unmarshal(rawParams, &params)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 	}
 
+	// Decode the requested process args, if any, and stash the raw JSON as a
+	// label so LastOperation can tell, field by field, once Atlas has
+	// reconciled them - see processArgsReconciled.
+	var processArgs *atlas.ProcessArgs
+	if len(params.ProcessArgs) > 0 {
+		processArgs = &atlas.ProcessArgs{}
+		if err := json.Unmarshal(params.ProcessArgs, processArgs); err != nil {
+			return nil, nil, nil, err
+		}
+		params.Cluster.SetLabel(ProcessArgsLabel, string(params.ProcessArgs))
+	}
+
 	// If the plan ID is specified we construct the provider object from the service and plan.
 	// The plan ID is optional during updates but not during creation.
 	if planID != "" {
 		provider, err := findProviderByServiceID(client, serviceID)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 
 		instanceSize, err := findInstanceSizeByPlanID(provider, planID)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 
 		if params.Cluster.ProviderSettings == nil {
@@ -284,9 +464,30 @@ func clusterFromParams(client atlas.Client, instanceID string, serviceID string,
 		// Configure provider based on service and plan.
 		params.Cluster.ProviderSettings.ProviderName = provider.Name
 		params.Cluster.ProviderSettings.InstanceSizeName = instanceSize.Name
+
+		// Fall back to the plan's declared autoscaling bounds if the caller
+		// didn't configure any of their own.
+		if params.Cluster.AutoScaling == (atlas.AutoScalingConfig{}) {
+			if defaults, ok := PlanAutoScalingDefaults[planID]; ok {
+				params.Cluster.AutoScaling = defaults
+			}
+		}
 	}
 
-	return params.Cluster, nil
+	return params.Cluster, processArgs, params.DeletionProtection, nil
+}
+
+// resolveDeletionProtection applies a caller-requested deletion protection
+// setting over a fallback, so that an omitted "deletionProtection" param
+// doesn't reset protection to some unrelated value. The fallback is the
+// broker-wide default for a fresh provision, and the existing instance's
+// current setting for an update - the same merge-don't-overwrite approach
+// mergeAutoScaling takes for autoscaling bounds.
+func resolveDeletionProtection(requested *bool, fallback bool) bool {
+	if requested != nil {
+		return *requested
+	}
+	return fallback
 }
 
 // clusterNameFromIDAndContext will return the display name if "instance_name"
@@ -313,12 +514,146 @@ func clusterNameFromIDAndContext(instanceID string, rawContext []byte) (string,
 	return NormalizeClusterName(instanceID), nil
 }
 
-// findClusterByInstanceID will find a cluster matching the instance ID either
-// by label or name.
-func findClusterByInstanceID(client atlas.Client, instanceID string) (atlas.Cluster, error) {
+// processArgsReconciled returns whether the process args last requested for a
+// cluster (if any) match what Atlas currently reports. Only the fields the
+// caller actually asked for are compared - Atlas's response also includes
+// every field it defaults on its own behalf, which will essentially never
+// match a full-struct comparison against the partial request. If the
+// requested fields haven't landed yet it also re-applies them, since Atlas
+// may have ignored the first attempt while the cluster was still being
+// created.
+func (b Broker) processArgsReconciled(client atlas.Client, cluster atlas.Cluster) bool {
+	desired := cluster.GetLabel(ProcessArgsLabel)
+	if desired == "" {
+		return true
+	}
+
+	var desiredFields map[string]interface{}
+	if err := json.Unmarshal([]byte(desired), &desiredFields); err != nil {
+		b.logger.Errorw("Failed to decode desired process args", "error", err, "cluster", cluster.Name)
+		return false
+	}
+
+	actual, err := client.GetProcessArgs(cluster.Name)
+	if err != nil {
+		b.logger.Errorw("Failed to fetch process args", "error", err, "cluster", cluster.Name)
+		return false
+	}
+
+	actualEncoded, err := json.Marshal(actual)
+	if err != nil {
+		b.logger.Errorw("Failed to encode process args", "error", err, "cluster", cluster.Name)
+		return false
+	}
+
+	var actualFields map[string]interface{}
+	if err := json.Unmarshal(actualEncoded, &actualFields); err != nil {
+		b.logger.Errorw("Failed to decode actual process args", "error", err, "cluster", cluster.Name)
+		return false
+	}
+
+	reconciled := true
+	for key, value := range desiredFields {
+		if !reflect.DeepEqual(actualFields[key], value) {
+			reconciled = false
+			break
+		}
+	}
+	if reconciled {
+		return true
+	}
+
+	var wanted atlas.ProcessArgs
+	if err := json.Unmarshal([]byte(desired), &wanted); err != nil {
+		b.logger.Errorw("Failed to decode desired process args", "error", err, "cluster", cluster.Name)
+		return false
+	}
+
+	if _, err := client.UpdateProcessArgs(cluster.Name, wanted); err != nil {
+		b.logger.Errorw("Failed to re-apply process args", "error", err, "cluster", cluster.Name)
+	}
+
+	return false
+}
+
+// checkForTerminalFailure inspects cluster events since the last poll for a
+// terminal failure and advances the cluster's event cursor label so the next
+// poll only looks at events that are actually new. It returns the failure
+// message, or an empty string if nothing terminal has happened.
+func (b Broker) checkForTerminalFailure(client atlas.Client, cluster atlas.Cluster) string {
+	since := cluster.GetLabel(EventCursorLabel)
+
+	events, err := client.ListClusterEvents(cluster.Name, since)
+	if err != nil {
+		b.logger.Errorw("Failed to list cluster events", "error", err, "cluster", cluster.Name)
+		return ""
+	}
+
+	message := ""
+	cursor := since
+
+	for _, event := range events {
+		if atlas.TerminalClusterFailureEvents[event.EventType] {
+			message = event.Message
+			if message == "" {
+				message = event.EventType
+			}
+		}
+
+		if event.Created > cursor {
+			cursor = event.Created
+		}
+	}
+
+	if cursor != since {
+		// Re-fetch the cluster rather than patching back the copy we were
+		// handed - cluster can come straight from DefaultClusterIndex, which
+		// may be stale by up to ClusterIndexRefreshInterval. Resubmitting it
+		// wholesale could silently overwrite ProviderSettings/AutoScaling
+		// changes made elsewhere (by Atlas's own autoscaler, say) since it was
+		// cached - the same "lost update" class of bug mergeAutoScaling
+		// prevents for OSB-driven updates.
+		fresh, err := client.GetCluster(cluster.Name)
+		if err != nil {
+			b.logger.Errorw("Failed to re-fetch cluster before advancing event cursor", "error", err, "cluster", cluster.Name)
+			return message
+		}
+
+		fresh.SetLabel(EventCursorLabel, cursor)
+		if _, err := client.UpdateCluster(*fresh); err != nil {
+			b.logger.Errorw("Failed to advance event cursor", "error", err, "cluster", cluster.Name)
+		}
+	}
+
+	return message
+}
+
+// isDeletionProtected returns whether a managed instance has deletion
+// protection enabled via its aosb-deletion-protection label.
+func isDeletionProtected(instance managedInstance) bool {
+	return instance.GetLabel(DeletionProtectionLabel) == "true"
+}
+
+// findInstanceByInstanceID will find a managed instance matching the
+// instance ID either by label or name, searching both dedicated clusters and
+// serverless instances.
+func findInstanceByInstanceID(client atlas.Client, instanceID string) (managedInstance, error) {
+	// Fast path: a fresh, indexed hit avoids scanning every cluster in the
+	// project.
+	if cluster, ok := DefaultClusterIndex.Get(instanceID); ok {
+		return cluster, nil
+	}
+
+	// Fall back to a direct lookup by the normalized cluster name, which
+	// covers the common case - instance ID used verbatim as the cluster name
+	// - without a full scan.
+	if cluster, err := client.GetCluster(NormalizeClusterName(instanceID)); err == nil {
+		return *cluster, nil
+	}
+
 	clusters, err := client.GetClusters()
 	if err != nil {
-		return atlas.Cluster{}, err
+		return nil, err
 	}
 
 	for _, cluster := range clusters {
@@ -330,5 +665,19 @@ func findClusterByInstanceID(client atlas.Client, instanceID string) (atlas.Clus
 		}
 	}
 
-	return atlas.Cluster{}, apiresponses.ErrInstanceDoesNotExist
+	serverlessInstances, err := client.ListServerlessInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, instance := range serverlessInstances {
+		matchesName := instance.Name == NormalizeClusterName(instanceID)
+		matchesLabel := instance.GetLabel(InstanceIDLabel) == instanceID
+
+		if matchesName || matchesLabel {
+			return instance, nil
+		}
+	}
+
+	return nil, apiresponses.ErrInstanceDoesNotExist
 }
@@ -0,0 +1,221 @@
+package broker
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
+)
+
+func TestIsDeletionProtected(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance managedInstance
+		want     bool
+	}{
+		{"cluster with protection enabled", atlas.Cluster{Labels: []atlas.Label{{Key: DeletionProtectionLabel, Value: "true"}}}, true},
+		{"cluster with protection disabled", atlas.Cluster{Labels: []atlas.Label{{Key: DeletionProtectionLabel, Value: "false"}}}, false},
+		{"cluster with no label", atlas.Cluster{}, false},
+		{"serverless instance with protection enabled", atlas.ServerlessInstance{Labels: []atlas.Label{{Key: DeletionProtectionLabel, Value: "true"}}}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isDeletionProtected(test.instance); got != test.want {
+				t.Errorf("isDeletionProtected() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// TestClusterFromParams_DeletionProtectionDisableThenDelete exercises the
+// plan-update-to-disable-then-delete path: an update that explicitly turns
+// deletion protection off must be reflected in the requested setting
+// clusterFromParams hands back, so a subsequent deprovision isn't blocked by
+// isDeletionProtected.
+func TestClusterFromParams_DeletionProtectionDisableThenDelete(t *testing.T) {
+	rawParams := []byte(`{"deletionProtection": false}`)
+
+	cluster, _, deletionProtection, err := clusterFromParams(nil, "instance-id", "", "", rawParams)
+	if err != nil {
+		t.Fatalf("clusterFromParams() error = %v", err)
+	}
+
+	cluster.SetLabel(DeletionProtectionLabel, strconv.FormatBool(resolveDeletionProtection(deletionProtection, true)))
+
+	if isDeletionProtected(*cluster) {
+		t.Fatal("expected deletion protection to be disabled after an explicit false, but it's still enabled")
+	}
+}
+
+// TestClusterFromParams_DeletionProtectionBlocksDelete covers the
+// blocked-delete path: when the caller doesn't say anything, the
+// broker-wide default applies and a delete of a protected instance must be
+// refused.
+func TestClusterFromParams_DeletionProtectionBlocksDelete(t *testing.T) {
+	originalDefault := DefaultDeletionProtection
+	DefaultDeletionProtection = true
+	defer func() { DefaultDeletionProtection = originalDefault }()
+
+	cluster, _, deletionProtection, err := clusterFromParams(nil, "instance-id", "", "", nil)
+	if err != nil {
+		t.Fatalf("clusterFromParams() error = %v", err)
+	}
+
+	cluster.SetLabel(DeletionProtectionLabel, strconv.FormatBool(resolveDeletionProtection(deletionProtection, DefaultDeletionProtection)))
+
+	if !isDeletionProtected(*cluster) {
+		t.Fatal("expected the broker-wide default to leave deletion protection enabled")
+	}
+}
+
+// TestClusterFromParams_DeletionProtectionPreservedOnUpdate guards against
+// the regression this fix addressed: an update that doesn't mention
+// deletionProtection at all must preserve the existing instance's setting,
+// not silently fall back to the broker-wide default.
+func TestClusterFromParams_DeletionProtectionPreservedOnUpdate(t *testing.T) {
+	originalDefault := DefaultDeletionProtection
+	DefaultDeletionProtection = false
+	defer func() { DefaultDeletionProtection = originalDefault }()
+
+	existing := atlas.Cluster{Labels: []atlas.Label{{Key: DeletionProtectionLabel, Value: "true"}}}
+
+	// An update that only resizes the cluster, say - no "deletionProtection"
+	// mentioned at all.
+	cluster, _, deletionProtection, err := clusterFromParams(nil, "instance-id", "", "", nil)
+	if err != nil {
+		t.Fatalf("clusterFromParams() error = %v", err)
+	}
+
+	cluster.SetLabel(DeletionProtectionLabel, strconv.FormatBool(resolveDeletionProtection(deletionProtection, isDeletionProtected(existing))))
+
+	if !isDeletionProtected(*cluster) {
+		t.Fatal("expected deletion protection to be preserved from the existing instance, not reset to the broker default")
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// TestMergeAutoScaling_UpdateWithOnlyProviderSettingsPreservesAutoScaling
+// submits an update that doesn't mention autoScaling at all (as if only
+// providerSettings had changed) and verifies the cluster's existing
+// autoscaling bounds stay intact.
+func TestMergeAutoScaling_UpdateWithOnlyProviderSettingsPreservesAutoScaling(t *testing.T) {
+	existing := atlas.AutoScalingConfig{
+		DiskEnabled: boolPtr(true),
+		Compute: atlas.ComputeAutoScalingConfig{
+			Enabled:          boolPtr(true),
+			ScaleDownEnabled: boolPtr(true),
+			MinInstanceSize:  "M10",
+			MaxInstanceSize:  "M40",
+		},
+	}
+
+	cluster := atlas.AutoScalingConfig{}
+	mergeAutoScaling(&cluster, existing)
+
+	if cluster.DiskEnabled == nil || !*cluster.DiskEnabled {
+		t.Error("expected DiskEnabled to be preserved from the existing cluster")
+	}
+	if cluster.Compute.Enabled == nil || !*cluster.Compute.Enabled {
+		t.Error("expected Compute.Enabled to be preserved from the existing cluster")
+	}
+	if cluster.Compute.ScaleDownEnabled == nil || !*cluster.Compute.ScaleDownEnabled {
+		t.Error("expected Compute.ScaleDownEnabled to be preserved from the existing cluster")
+	}
+	if cluster.Compute.MinInstanceSize != "M10" || cluster.Compute.MaxInstanceSize != "M40" {
+		t.Errorf("expected instance size bounds to be preserved, got %q/%q", cluster.Compute.MinInstanceSize, cluster.Compute.MaxInstanceSize)
+	}
+}
+
+// TestMergeAutoScaling_ExplicitDisableIsNotClobbered guards against the
+// regression this request's fix addressed: an update that explicitly turns
+// autoscaling off must not be overwritten by the previously-enabled existing
+// value.
+func TestMergeAutoScaling_ExplicitDisableIsNotClobbered(t *testing.T) {
+	existing := atlas.AutoScalingConfig{
+		DiskEnabled: boolPtr(true),
+		Compute: atlas.ComputeAutoScalingConfig{
+			Enabled:          boolPtr(true),
+			ScaleDownEnabled: boolPtr(true),
+		},
+	}
+
+	cluster := atlas.AutoScalingConfig{
+		DiskEnabled: boolPtr(false),
+		Compute: atlas.ComputeAutoScalingConfig{
+			Enabled:          boolPtr(false),
+			ScaleDownEnabled: boolPtr(false),
+		},
+	}
+	mergeAutoScaling(&cluster, existing)
+
+	if cluster.DiskEnabled == nil || *cluster.DiskEnabled {
+		t.Error("expected the explicit DiskEnabled=false to survive the merge")
+	}
+	if cluster.Compute.Enabled == nil || *cluster.Compute.Enabled {
+		t.Error("expected the explicit Compute.Enabled=false to survive the merge")
+	}
+	if cluster.Compute.ScaleDownEnabled == nil || *cluster.Compute.ScaleDownEnabled {
+		t.Error("expected the explicit Compute.ScaleDownEnabled=false to survive the merge")
+	}
+}
+
+// TestProcessArgsReconciled_OnlyComparesRequestedFields guards against the
+// permanently-stuck-poll regression: Atlas reporting additional fields the
+// caller never asked about must not keep the poll InProgress forever.
+func TestProcessArgsReconciled_OnlyComparesRequestedFields(t *testing.T) {
+	desired, err := json.Marshal(atlas.ProcessArgs{OplogSizeMB: 1000})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	cluster := atlas.Cluster{
+		Name:   "cluster0",
+		Labels: []atlas.Label{{Key: ProcessArgsLabel, Value: string(desired)}},
+	}
+
+	b := Broker{logger: noopLogger()}
+	client := &fakeAtlasClient{
+		processArgs: atlas.ProcessArgs{
+			DefaultReadConcern: "local",
+			OplogSizeMB:        1000,
+		},
+	}
+
+	if !b.processArgsReconciled(client, cluster) {
+		t.Fatal("expected the poll to consider process args reconciled once the requested field matches, regardless of Atlas's other defaults")
+	}
+}
+
+// TestProcessArgsReconciled_ExplicitFalseBoolean guards against the
+// permanently-stuck-poll regression for boolean fields specifically: with a
+// plain bool and omitempty, an explicit "false" would marshal to nothing on
+// both the request and Atlas's response, so the two would never compare
+// equal and the poll would never reconcile.
+func TestProcessArgsReconciled_ExplicitFalseBoolean(t *testing.T) {
+	desired, err := json.Marshal(atlas.ProcessArgs{JavascriptEnabled: boolPtr(false)})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	cluster := atlas.Cluster{
+		Name:   "cluster0",
+		Labels: []atlas.Label{{Key: ProcessArgsLabel, Value: string(desired)}},
+	}
+
+	b := Broker{logger: noopLogger()}
+	client := &fakeAtlasClient{
+		processArgs: atlas.ProcessArgs{
+			DefaultReadConcern: "local",
+			JavascriptEnabled:  boolPtr(false),
+		},
+	}
+
+	if !b.processArgsReconciled(client, cluster) {
+		t.Fatal("expected the poll to reconcile an explicit javascriptEnabled=false, not wait on it forever")
+	}
+}
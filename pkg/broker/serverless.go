@@ -0,0 +1,107 @@
+package broker
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
+	"github.com/pivotal-cf/brokerapi"
+)
+
+// ServerlessPlanIDs marks which plan IDs should be provisioned as Atlas
+// serverless instances rather than dedicated clusters. It's populated at
+// broker start-up from the "serverless: true" flag on a plan in the service
+// catalog.
+var ServerlessPlanIDs = map[string]bool{}
+
+// serverlessInstanceFromParams constructs a serverless instance definition
+// from raw provision/update parameters. Serverless instances take a sibling
+// "cluster" block too, same as dedicated clusters, but only the provider
+// settings are meaningful - there's no instance size to resolve from the
+// plan. It also returns the requested deletion protection setting, if the
+// caller specified one - same as clusterFromParams, the caller decides what
+// to fall back to when it's nil, since that differs between a fresh
+// provision and an update.
+func serverlessInstanceFromParams(rawParams []byte) (*atlas.ServerlessInstance, *bool, error) {
+	params := struct {
+		Cluster            *atlas.ServerlessInstance `json:"cluster"`
+		DeletionProtection *bool                     `json:"deletionProtection"`
+	}{
+		Cluster: &atlas.ServerlessInstance{},
+	}
+
+	if len(rawParams) > 0 {
+		if err := json.Unmarshal(rawParams, &params); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return params.Cluster, params.DeletionProtection, nil
+}
+
+// provisionServerless creates a new Atlas serverless instance with the
+// instance ID as its name.
+func (b Broker) provisionServerless(client atlas.Client, instanceID string, details brokerapi.ProvisionDetails) (spec brokerapi.ProvisionedServiceSpec, err error) {
+	instance, deletionProtection, err := serverlessInstanceFromParams(details.RawParameters)
+	if err != nil {
+		b.logger.Errorw("Couldn't create serverless instance from the passed parameters", "error", err, "instance_id", instanceID, "details", details)
+		return
+	}
+
+	name, err := clusterNameFromIDAndContext(instanceID, details.RawContext)
+	if err != nil {
+		b.logger.Errorw("Couldn't create instance name", "error", err, "instance_id", instanceID, "details", details)
+		return
+	}
+	instance.Name = name
+
+	instance.SetLabel(InstanceIDLabel, instanceID)
+	instance.SetLabel(DeletionProtectionLabel, strconv.FormatBool(resolveDeletionProtection(deletionProtection, DefaultDeletionProtection)))
+
+	resultingInstance, err := client.CreateServerlessInstance(*instance)
+	if err != nil {
+		b.logger.Errorw("Failed to create Atlas serverless instance", "error", err, "instance", instance)
+		err = atlasToAPIError(err)
+		return
+	}
+
+	b.logger.Infow("Successfully started Atlas serverless instance creation process", "instance_id", instanceID, "instance", resultingInstance)
+
+	return brokerapi.ProvisionedServiceSpec{
+		IsAsync:       true,
+		OperationData: OperationProvision,
+		DashboardURL:  client.GetDashboardURL(resultingInstance.Name),
+	}, nil
+}
+
+// updateServerless changes the backing provider/region of an existing Atlas
+// serverless instance asynchronously.
+func (b Broker) updateServerless(client atlas.Client, instanceID string, details brokerapi.UpdateDetails, existing atlas.ServerlessInstance) (spec brokerapi.UpdateServiceSpec, err error) {
+	instance, deletionProtection, err := serverlessInstanceFromParams(details.RawParameters)
+	if err != nil {
+		return
+	}
+	instance.Name = existing.Name
+
+	// Preserve deletion protection, rather than overwrite it, the same way
+	// Update does for dedicated clusters - an update that doesn't mention
+	// "deletionProtection" shouldn't silently revert it to the broker-wide
+	// default.
+	existingDeletionProtection := isDeletionProtected(existing)
+	instance.SetLabel(DeletionProtectionLabel, strconv.FormatBool(resolveDeletionProtection(deletionProtection, existingDeletionProtection)))
+
+	resultingInstance, err := client.UpdateServerlessInstance(*instance)
+	if err != nil {
+		b.logger.Errorw("Failed to update Atlas serverless instance", "error", err, "instance", instance)
+		err = atlasToAPIError(err)
+		return
+	}
+
+	b.logger.Infow("Successfully started Atlas serverless instance update process", "instance_id", instanceID, "instance", resultingInstance)
+
+	return brokerapi.UpdateServiceSpec{
+		IsAsync:       true,
+		OperationData: OperationUpdate,
+		DashboardURL:  client.GetDashboardURL(resultingInstance.Name),
+	}, nil
+}
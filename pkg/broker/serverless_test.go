@@ -0,0 +1,42 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-service-broker/pkg/atlas"
+	"github.com/pivotal-cf/brokerapi"
+)
+
+// TestUpdateServerless_DeletionProtectionPreservedOnUpdate guards against the
+// serverless equivalent of the regression TestClusterFromParams_DeletionProtectionPreservedOnUpdate
+// covers for dedicated clusters: an update that doesn't mention
+// deletionProtection at all must preserve the existing instance's setting,
+// not silently fall back to the broker-wide default.
+func TestUpdateServerless_DeletionProtectionPreservedOnUpdate(t *testing.T) {
+	originalDefault := DefaultDeletionProtection
+	DefaultDeletionProtection = false
+	defer func() { DefaultDeletionProtection = originalDefault }()
+
+	existing := atlas.ServerlessInstance{
+		Name:   "instance-0",
+		Labels: []atlas.Label{{Key: DeletionProtectionLabel, Value: "true"}},
+	}
+
+	client := &fakeAtlasClient{}
+	b := Broker{logger: noopLogger()}
+
+	// An update that only changes the region, say - no "deletionProtection"
+	// mentioned at all.
+	_, err := b.updateServerless(client, "instance-id", brokerapi.UpdateDetails{}, existing)
+	if err != nil {
+		t.Fatalf("updateServerless() error = %v", err)
+	}
+
+	if len(client.updatedServerlessInstances) != 1 {
+		t.Fatalf("expected exactly one UpdateServerlessInstance call, got %d", len(client.updatedServerlessInstances))
+	}
+
+	if !isDeletionProtected(client.updatedServerlessInstances[0]) {
+		t.Fatal("expected deletion protection to be preserved from the existing instance, not reset to the broker default")
+	}
+}